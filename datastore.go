@@ -0,0 +1,305 @@
+package goquery
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// DataStore is the entry point for fluent queries against a single
+// *sql.DB. It pairs the connection with the DbDialect used to render
+// statements for it, and accumulates QueryHook/Stats state, so it's always
+// used through a pointer.
+type DataStore struct {
+	DB      *sql.DB
+	Dialect DbDialect
+
+	mu    sync.Mutex
+	hooks []QueryHook
+	stats DBStats
+}
+
+// Tx wraps a *sql.Tx so fluent builders can be handed either a DataStore or
+// an in-flight transaction.
+type Tx struct {
+	tx *sql.Tx
+}
+
+func (t *Tx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return t.tx.ExecContext(ctx, query, args...)
+}
+
+func (t *Tx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return t.tx.QueryContext(ctx, query, args...)
+}
+
+// NewDataStore builds a DataStore for db, rendering statements with
+// dialect. dialect may be a DbDialect value, or the name of a dialect
+// registered on Dialects (e.g. "postgres", "mysql", "sqlite", "mssql",
+// "duckdb").
+func NewDataStore(db *sql.DB, dialect any) (*DataStore, error) {
+	switch d := dialect.(type) {
+	case DbDialect:
+		return &DataStore{DB: db, Dialect: d}, nil
+	case string:
+		resolved, err := Dialects.Get(d)
+		if err != nil {
+			return nil, err
+		}
+		return &DataStore{DB: db, Dialect: resolved}, nil
+	default:
+		return nil, fmt.Errorf("goquery: dialect must be a DbDialect or a registered dialect name, got %T", dialect)
+	}
+}
+
+// Insert begins building a FluentInsert against ds.
+func (d *DataStore) Insert(ds DataSet) *FluentInsert {
+	return &FluentInsert{store: d, ds: ds, batchSize: defaultBatchSize}
+}
+
+// InsertInput carries everything InsertRecs needs to run an insert,
+// independent of how it was built up.
+type InsertInput struct {
+	Dataset    DataSet
+	Records    interface{}
+	Batch      bool
+	BatchSize  int
+	PanicOnErr bool
+	// Columns optionally restricts (and orders) which of Dataset.Fields()'s
+	// columns are written. A nil/empty slice means all of them.
+	Columns []string
+	// OnConflictCols, ConflictDoNothing, and ConflictUpdates describe an
+	// upsert. OnConflictCols is empty when no conflict handling was
+	// requested.
+	OnConflictCols    []string
+	ConflictDoNothing bool
+	ConflictUpdates   []ConflictUpdate
+	// Returning names columns of the inserted row(s) to read back into
+	// Dest. Empty means no values are read back.
+	Returning []string
+	// Dest is a pointer to a slice of structs to scan Returning values
+	// into, accumulated across every batch of a batched insert.
+	Dest any
+}
+
+// InsertRecs inserts in.Records (a slice of structs matching
+// in.Dataset.Fields()) into the table named by in.Dataset.Entity(). If tx
+// is non-nil the insert runs inside it, otherwise it runs directly against
+// d.DB. Every registered QueryHook observes one QueryEvent per batch.
+func (d *DataStore) InsertRecs(ctx context.Context, tx *Tx, in InsertInput) error {
+	recs := reflect.ValueOf(in.Records)
+	if recs.Kind() == reflect.Ptr {
+		recs = recs.Elem()
+	}
+	if recs.Kind() != reflect.Slice {
+		return d.fail(in, errors.New("goquery: InsertRecs requires a slice of records"))
+	}
+
+	fields := fieldsOf(in.Dataset)
+	if len(in.Columns) > 0 {
+		fields = filterColumns(fields, in.Columns)
+	}
+
+	batchSize := in.BatchSize
+	if !in.Batch || batchSize <= 0 {
+		batchSize = recs.Len()
+	}
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	for start := 0; start < recs.Len(); start += batchSize {
+		end := start + batchSize
+		if end > recs.Len() {
+			end = recs.Len()
+		}
+		if err := d.insertBatch(ctx, tx, in, fields, recs.Slice(start, end)); err != nil {
+			return d.fail(in, err)
+		}
+	}
+	return nil
+}
+
+func (d *DataStore) fail(in InsertInput, err error) error {
+	if in.PanicOnErr {
+		panic(err)
+	}
+	return err
+}
+
+func (d *DataStore) insertBatch(ctx context.Context, tx *Tx, in InsertInput, fields []fieldInfo, batch reflect.Value) error {
+	if len(in.Returning) > 0 {
+		return d.insertBatchReturning(ctx, tx, in, fields, batch)
+	}
+
+	stmt, args, err := buildInsertStmt(d.Dialect, in, fields, batch)
+	if err != nil {
+		return err
+	}
+
+	return d.runHooks(ctx, OpInsert, in.Dataset.Entity(), stmt, args, func(ctx context.Context) (int64, error) {
+		res, err := d.exec(ctx, tx, stmt, args)
+		if err != nil {
+			return 0, err
+		}
+		n, _ := res.RowsAffected()
+		return n, nil
+	})
+}
+
+// insertBatchReturning inserts batch and scans the requested Returning
+// columns into in.Dest: via a RETURNING clause on dialects that support
+// it, or via LastInsertId() plus a follow-up SELECT otherwise.
+func (d *DataStore) insertBatchReturning(ctx context.Context, tx *Tx, in InsertInput, fields []fieldInfo, batch reflect.Value) error {
+	if in.Dest == nil {
+		return errors.New("goquery: Returning requires Into(dest) to scan results into")
+	}
+	destPtr := reflect.ValueOf(in.Dest)
+	if destPtr.Kind() != reflect.Ptr || destPtr.Elem().Kind() != reflect.Slice {
+		return errors.New("goquery: Into(dest) requires a pointer to a slice")
+	}
+	destSlice := destPtr.Elem()
+
+	stmt, args, err := buildInsertStmt(d.Dialect, in, fields, batch)
+	if err != nil {
+		return err
+	}
+
+	if d.Dialect.SupportsReturning {
+		stmt += " " + d.Dialect.ReturningClause(in.Returning)
+		return d.runHooks(ctx, OpInsert, in.Dataset.Entity(), stmt, args, func(ctx context.Context) (int64, error) {
+			rows, err := d.query(ctx, tx, stmt, args)
+			if err != nil {
+				return 0, err
+			}
+			defer rows.Close()
+			return scanRowsInto(rows, in.Returning, destSlice)
+		})
+	}
+
+	if len(in.OnConflictCols) > 0 {
+		return errors.New("goquery: Returning combined with OnConflict requires RETURNING support, which this dialect lacks (LastInsertId() doesn't apply to a MERGE/upsert statement)")
+	}
+
+	if batch.Len() != 1 {
+		return errors.New("goquery: Returning without RETURNING support only works one row at a time")
+	}
+
+	return d.runHooks(ctx, OpInsert, in.Dataset.Entity(), stmt, args, func(ctx context.Context) (int64, error) {
+		res, err := d.exec(ctx, tx, stmt, args)
+		if err != nil {
+			return 0, err
+		}
+		lastID, err := res.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		pk := in.Returning[0]
+		selectStmt := fmt.Sprintf("SELECT %s FROM %s WHERE %s = %s",
+			strings.Join(in.Returning, ", "), in.Dataset.Entity(), pk, d.Dialect.Bind(pk, 0))
+		rows, err := d.query(ctx, tx, selectStmt, []interface{}{lastID})
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		return scanRowsInto(rows, in.Returning, destSlice)
+	})
+}
+
+func (d *DataStore) exec(ctx context.Context, tx *Tx, stmt string, args []interface{}) (sql.Result, error) {
+	if tx != nil {
+		return tx.ExecContext(ctx, stmt, args...)
+	}
+	return d.DB.ExecContext(ctx, stmt, args...)
+}
+
+func (d *DataStore) query(ctx context.Context, tx *Tx, stmt string, args []interface{}) (*sql.Rows, error) {
+	if tx != nil {
+		return tx.QueryContext(ctx, stmt, args...)
+	}
+	return d.DB.QueryContext(ctx, stmt, args...)
+}
+
+// scanRowsInto scans every row of rows into a newly appended element of
+// destSlice, matching cols to destSlice's element struct fields by `db`
+// tag (falling back to the lowercased field name), and returns the row
+// count scanned.
+func scanRowsInto(rows *sql.Rows, cols []string, destSlice reflect.Value) (int64, error) {
+	elemType := destSlice.Type().Elem()
+	fieldIndex := make([]int, len(cols))
+	for i, c := range cols {
+		idx, err := fieldIndexForColumn(elemType, c)
+		if err != nil {
+			return 0, err
+		}
+		fieldIndex[i] = idx
+	}
+
+	var n int64
+	for rows.Next() {
+		elem := reflect.New(elemType).Elem()
+		ptrs := make([]interface{}, len(cols))
+		for i, idx := range fieldIndex {
+			ptrs[i] = elem.Field(idx).Addr().Interface()
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return n, err
+		}
+		destSlice.Set(reflect.Append(destSlice, elem))
+		n++
+	}
+	return n, rows.Err()
+}
+
+func fieldIndexForColumn(typ reflect.Type, col string) (int, error) {
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		name := sf.Tag.Get("db")
+		if name == "" {
+			name = strings.ToLower(sf.Name)
+		}
+		if name == col {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("goquery: %s has no field tagged for column %q", typ, col)
+}
+
+func buildInsertStmt(dialect DbDialect, in InsertInput, fields []fieldInfo, batch reflect.Value) (string, []interface{}, error) {
+	cols := make([]string, len(fields))
+	for i, f := range fields {
+		cols[i] = f.Column
+	}
+
+	var args []interface{}
+	valueGroups := make([]string, batch.Len())
+	for r := 0; r < batch.Len(); r++ {
+		rec := batch.Index(r)
+		placeholders := make([]string, len(fields))
+		for c, f := range fields {
+			args = append(args, rec.Field(f.Index).Interface())
+			placeholders[c] = dialect.Bind(f.Column, len(args)-1)
+		}
+		valueGroups[r] = "(" + strings.Join(placeholders, ", ") + ")"
+	}
+
+	if len(in.OnConflictCols) > 0 && dialect.ConflictClause == nil {
+		if dialect.MergeUpsertStmt == nil {
+			return "", nil, errors.New("goquery: dialect does not support ON CONFLICT/upsert")
+		}
+		stmt := dialect.MergeUpsertStmt(in.Dataset.Entity(), cols, valueGroups, in.OnConflictCols, in.ConflictDoNothing, in.ConflictUpdates)
+		return stmt, args, nil
+	}
+
+	stmt := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", in.Dataset.Entity(), strings.Join(cols, ", "), strings.Join(valueGroups, ", "))
+
+	if len(in.OnConflictCols) > 0 {
+		stmt += " " + dialect.ConflictClause(in.OnConflictCols, in.ConflictDoNothing, in.ConflictUpdates)
+	}
+
+	return stmt, args, nil
+}
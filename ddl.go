@@ -0,0 +1,192 @@
+package goquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CreateOptions configures DataStore.CreateTable.
+type CreateOptions struct {
+	// IfNotExists adds IF NOT EXISTS to the generated CREATE TABLE.
+	IfNotExists bool
+}
+
+// columnTag is the parsed form of a `goquery:"pk,autoincrement,notnull,
+// default=...,unique,index,fk=other.id"` struct tag.
+type columnTag struct {
+	PK            bool
+	AutoIncrement bool
+	NotNull       bool
+	Unique        bool
+	Index         bool
+	Default       string
+	ForeignKey    string // "<table>.<column>"
+}
+
+func parseColumnTag(tag string) columnTag {
+	var ct columnTag
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "":
+		case part == "pk":
+			ct.PK = true
+		case part == "autoincrement":
+			ct.AutoIncrement = true
+		case part == "notnull":
+			ct.NotNull = true
+		case part == "unique":
+			ct.Unique = true
+		case part == "index":
+			ct.Index = true
+		case strings.HasPrefix(part, "default="):
+			ct.Default = strings.TrimPrefix(part, "default=")
+		case strings.HasPrefix(part, "fk="):
+			ct.ForeignKey = strings.TrimPrefix(part, "fk=")
+		}
+	}
+	return ct
+}
+
+// CreateTable issues a CREATE TABLE for ds, deriving columns from
+// ds.Fields()'s `db` and `goquery` struct tags.
+func (d *DataStore) CreateTable(ds DataSet, opts CreateOptions) error {
+	return d.CreateTableContext(context.Background(), ds, opts)
+}
+
+func (d *DataStore) CreateTableContext(ctx context.Context, ds DataSet, opts CreateOptions) error {
+	fields := fieldsOf(ds)
+	parts := make([]string, 0, len(fields))
+	var indexCols []string
+
+	for _, f := range fields {
+		tag := parseColumnTag(f.GoqueryTag)
+		parts = append(parts, d.columnDef(f, tag))
+		if tag.ForeignKey != "" {
+			refTable, refCol, err := splitForeignKey(tag.ForeignKey)
+			if err != nil {
+				return err
+			}
+			parts = append(parts, fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s(%s)", f.Column, refTable, refCol))
+		}
+		if tag.Index {
+			indexCols = append(indexCols, f.Column)
+		}
+	}
+
+	ifNotExists := ""
+	if opts.IfNotExists {
+		ifNotExists = "IF NOT EXISTS "
+	}
+	stmt := fmt.Sprintf("CREATE TABLE %s%s (\n  %s\n)", ifNotExists, ds.Entity(), strings.Join(parts, ",\n  "))
+	if _, err := d.exec(ctx, nil, stmt, nil); err != nil {
+		return err
+	}
+
+	for _, col := range indexCols {
+		idxName := fmt.Sprintf("idx_%s_%s", strings.ReplaceAll(ds.Entity(), ".", "_"), col)
+		idxStmt := fmt.Sprintf("CREATE INDEX %s ON %s (%s)", idxName, ds.Entity(), col)
+		if _, err := d.exec(ctx, nil, idxStmt, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropTable issues a DROP TABLE for ds.
+func (d *DataStore) DropTable(ds DataSet, ifExists bool) error {
+	return d.DropTableContext(context.Background(), ds, ifExists)
+}
+
+func (d *DataStore) DropTableContext(ctx context.Context, ds DataSet, ifExists bool) error {
+	clause := ""
+	if ifExists {
+		clause = "IF EXISTS "
+	}
+	_, err := d.exec(ctx, nil, fmt.Sprintf("DROP TABLE %s%s", clause, ds.Entity()), nil)
+	return err
+}
+
+// Migrate diffs ds.Fields() against the database's existing columns and
+// issues an ALTER TABLE ADD COLUMN for each one missing. It never removes
+// or alters an existing column.
+func (d *DataStore) Migrate(ds DataSet) error {
+	return d.MigrateContext(context.Background(), ds)
+}
+
+func (d *DataStore) MigrateContext(ctx context.Context, ds DataSet) error {
+	existing, err := d.existingColumns(ctx, ds.Entity())
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fieldsOf(ds) {
+		if existing[f.Column] {
+			continue
+		}
+		tag := parseColumnTag(f.GoqueryTag)
+		stmt := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", ds.Entity(), d.columnDef(f, tag))
+		if _, err := d.exec(ctx, nil, stmt, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DataStore) existingColumns(ctx context.Context, table string) (map[string]bool, error) {
+	if d.Dialect.ColumnsStmt == nil {
+		return nil, errors.New("goquery: dialect does not support column introspection (Migrate)")
+	}
+
+	rows, err := d.query(ctx, nil, d.Dialect.ColumnsStmt(table), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		cols[name] = true
+	}
+	return cols, rows.Err()
+}
+
+// columnDef renders one column's definition for CREATE TABLE/ALTER TABLE.
+func (d *DataStore) columnDef(f fieldInfo, tag columnTag) string {
+	sqlType := "TEXT"
+	if d.Dialect.SqlType != nil {
+		sqlType = d.Dialect.SqlType(f.Type, tag)
+	}
+
+	parts := []string{f.Column, sqlType}
+	if tag.PK {
+		parts = append(parts, "PRIMARY KEY")
+	}
+	if tag.AutoIncrement && d.Dialect.AutoIncrementKeyword != "" {
+		parts = append(parts, d.Dialect.AutoIncrementKeyword)
+	}
+	if tag.NotNull {
+		parts = append(parts, "NOT NULL")
+	}
+	if tag.Unique {
+		parts = append(parts, "UNIQUE")
+	}
+	if tag.Default != "" {
+		parts = append(parts, "DEFAULT "+tag.Default)
+	}
+	return strings.Join(parts, " ")
+}
+
+func splitForeignKey(fk string) (table, column string, err error) {
+	idx := strings.LastIndex(fk, ".")
+	if idx < 0 {
+		return "", "", fmt.Errorf("goquery: invalid fk tag %q, expected \"table.column\"", fk)
+	}
+	return fk[:idx], fk[idx+1:], nil
+}
@@ -0,0 +1,130 @@
+package goquery
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DbDialect captures the SQL dialect-specific behavior (placeholder style,
+// identifier quoting, existence checks, ...) needed to render statements
+// that work across Postgres, MySQL, SQLite, DuckDB, MSSQL, and friends.
+type DbDialect struct {
+	// TableExistsStmt is a query returning a single boolean row indicating
+	// whether the bound table name exists.
+	TableExistsStmt string
+	// Bind renders the placeholder for the i'th (0-indexed) bound parameter
+	// for the given field name.
+	Bind func(field string, i int) string
+	// QuoteIdent quotes a table/column identifier in the dialect's style.
+	QuoteIdent func(ident string) string
+	// LimitOffset renders a LIMIT/OFFSET (or dialect equivalent) clause.
+	LimitOffset func(limit, offset int) string
+	// ConflictClause renders the ON CONFLICT/ON DUPLICATE KEY tail of an
+	// INSERT statement. conflictCols names the columns identifying a
+	// conflicting row. doNothing and updates are mutually exclusive; this
+	// is only called when a conflict clause was actually requested. nil
+	// means the dialect has no INSERT-tail upsert (e.g. MSSQL, which
+	// expresses upsert via a MERGE statement instead).
+	ConflictClause func(conflictCols []string, doNothing bool, updates []ConflictUpdate) string
+	// MergeUpsertStmt renders an entire upsert statement (e.g. MSSQL's
+	// MERGE) for dialects where ConflictClause can't express it as an
+	// INSERT tail. Only consulted when ConflictClause is nil and an
+	// upsert was requested; valueGroups are the already-rendered
+	// "(placeholder, ...)" row tuples buildInsertStmt would otherwise
+	// have put after a plain INSERT's VALUES.
+	MergeUpsertStmt func(entity string, cols []string, valueGroups []string, conflictCols []string, doNothing bool, updates []ConflictUpdate) string
+	// SupportsReturning reports whether the dialect can append a RETURNING
+	// clause to an INSERT. When false, FluentInsert.Returning instead falls
+	// back to sql.Result.LastInsertId() plus a follow-up SELECT.
+	SupportsReturning bool
+	// ReturningClause renders the RETURNING tail of an INSERT statement for
+	// the given columns. Only called when SupportsReturning is true.
+	ReturningClause func(cols []string) string
+	// SqlType maps a Go field's type and parsed `goquery` tag to a column
+	// type for CREATE TABLE/ALTER TABLE, e.g. string -> TEXT, int64 ->
+	// BIGINT. A `goquery:"autoincrement"` tag may change the type itself
+	// (e.g. Postgres's SERIAL) rather than going through
+	// AutoIncrementKeyword.
+	SqlType func(t reflect.Type, tag columnTag) string
+	// AutoIncrementKeyword is appended to a column definition already
+	// carrying a `goquery:"autoincrement"` tag, unless SqlType already
+	// encoded auto-increment into the type itself (e.g. Postgres SERIAL,
+	// where this is left empty).
+	AutoIncrementKeyword string
+	// ColumnsStmt renders a query returning one row per existing column of
+	// table, with the column name as the first (and only required)
+	// result column. Used by Migrate to diff a table against a struct.
+	ColumnsStmt func(table string) string
+	// UseFromClauseForMultipleUpdateTables selects how FluentUpdate renders
+	// a multi-table update: true for Postgres-style "UPDATE a SET ... FROM
+	// b WHERE ...", false for MySQL-style "UPDATE a JOIN b ON ... SET ...".
+	UseFromClauseForMultipleUpdateTables bool
+}
+
+// ConflictUpdate pairs a column with the SQL expression assigned to it in
+// an upsert's DO UPDATE SET clause. A zero Expr means "use the value that
+// was about to be inserted" -- ConflictClause implementations render that
+// in their own dialect-specific syntax (e.g. Postgres/SQLite EXCLUDED.col,
+// MySQL VALUES(col)).
+type ConflictUpdate struct {
+	Column string
+	Expr   string
+}
+
+// excludedStyleConflictClause renders the `ON CONFLICT (...) DO [NOTHING |
+// UPDATE SET col = EXCLUDED.col]` syntax shared by Postgres, SQLite, and
+// DuckDB.
+func excludedStyleConflictClause(conflictCols []string, doNothing bool, updates []ConflictUpdate) string {
+	clause := fmt.Sprintf("ON CONFLICT (%s)", strings.Join(conflictCols, ", "))
+	if doNothing || len(updates) == 0 {
+		return clause + " DO NOTHING"
+	}
+	sets := make([]string, len(updates))
+	for i, u := range updates {
+		expr := u.Expr
+		if expr == "" {
+			expr = "EXCLUDED." + u.Column
+		}
+		sets[i] = fmt.Sprintf("%s = %s", u.Column, expr)
+	}
+	return clause + " DO UPDATE SET " + strings.Join(sets, ", ")
+}
+
+func doubleQuoteIdent(ident string) string { return `"` + ident + `"` }
+
+// quoteQualifiedIdent quotes a possibly dot-qualified identifier (e.g.
+// "orders.customer_id") segment by segment, so a dialect's QuoteIdent
+// never has to quote a literal dot as part of a single identifier token.
+func quoteQualifiedIdent(dialect DbDialect, ident string) string {
+	if dialect.QuoteIdent == nil {
+		return ident
+	}
+	parts := strings.Split(ident, ".")
+	for i, p := range parts {
+		parts[i] = dialect.QuoteIdent(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+func standardLimitOffset(limit, offset int) string {
+	return fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+// lastPathSegment returns the table part of a possibly schema-qualified
+// entity name ("schema.table" -> "table").
+func lastPathSegment(entity string) string {
+	if idx := strings.LastIndex(entity, "."); idx >= 0 {
+		return entity[idx+1:]
+	}
+	return entity
+}
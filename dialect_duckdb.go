@@ -1,10 +1,46 @@
 package goquery
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
 
 var duckdbDialect = DbDialect{
 	TableExistsStmt: `SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = $1)`,
 	Bind: func(field string, i int) string {
 		return fmt.Sprintf("$%d", i+1)
 	},
+	QuoteIdent:        doubleQuoteIdent,
+	LimitOffset:       standardLimitOffset,
+	ConflictClause:    excludedStyleConflictClause,
+	SupportsReturning: true,
+	ReturningClause: func(cols []string) string {
+		return "RETURNING " + strings.Join(cols, ", ")
+	},
+	SqlType: func(t reflect.Type, tag columnTag) string {
+		switch {
+		case t == reflect.TypeOf(time.Time{}):
+			return "TIMESTAMP"
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+			return "BLOB"
+		}
+		switch t.Kind() {
+		case reflect.Int64:
+			return "BIGINT"
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+			return "INTEGER"
+		case reflect.Float32, reflect.Float64:
+			return "DOUBLE"
+		case reflect.Bool:
+			return "BOOLEAN"
+		default:
+			return "TEXT"
+		}
+	},
+	ColumnsStmt: func(table string) string {
+		return fmt.Sprintf("SELECT column_name FROM information_schema.columns WHERE table_name = '%s'", lastPathSegment(table))
+	},
+	UseFromClauseForMultipleUpdateTables: true,
 }
@@ -0,0 +1,87 @@
+package goquery
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var mssqlDialect = DbDialect{
+	TableExistsStmt: `SELECT CASE WHEN EXISTS (SELECT 1 FROM sys.tables WHERE name = @p1) THEN 1 ELSE 0 END`,
+	Bind: func(field string, i int) string {
+		return fmt.Sprintf("@p%d", i+1)
+	},
+	QuoteIdent: func(ident string) string { return "[" + ident + "]" },
+	LimitOffset: func(limit, offset int) string {
+		return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", offset, limit)
+	},
+	// ConflictClause is intentionally nil: MSSQL has no INSERT-tail
+	// upsert syntax. MergeUpsertStmt below renders the MERGE statement
+	// MSSQL needs instead.
+	MergeUpsertStmt: mssqlMergeUpsertStmt,
+	// SupportsReturning is false: MSSQL has OUTPUT, not RETURNING: a future
+	// Output-clause hook would be the right place for it.
+	SupportsReturning: false,
+	SqlType: func(t reflect.Type, tag columnTag) string {
+		switch {
+		case t == reflect.TypeOf(time.Time{}):
+			return "DATETIME2"
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+			return "VARBINARY(MAX)"
+		}
+		switch t.Kind() {
+		case reflect.Int64:
+			return "BIGINT"
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+			return "INT"
+		case reflect.Float32, reflect.Float64:
+			return "FLOAT"
+		case reflect.Bool:
+			return "BIT"
+		default:
+			return "NVARCHAR(255)"
+		}
+	},
+	AutoIncrementKeyword: "IDENTITY(1,1)",
+	ColumnsStmt: func(table string) string {
+		return fmt.Sprintf("SELECT name FROM sys.columns WHERE object_id = OBJECT_ID('%s')", table)
+	},
+}
+
+// mssqlMergeUpsertStmt renders an upsert as a MERGE statement, MSSQL's
+// only mechanism for it. valueGroups becomes the USING (VALUES ...)
+// source, aliased column-for-column with cols so WHEN MATCHED/WHEN NOT
+// MATCHED can reference source.<col> uniformly.
+func mssqlMergeUpsertStmt(entity string, cols []string, valueGroups []string, conflictCols []string, doNothing bool, updates []ConflictUpdate) string {
+	onConds := make([]string, len(conflictCols))
+	for i, c := range conflictCols {
+		onConds[i] = fmt.Sprintf("target.%s = source.%s", c, c)
+	}
+
+	stmt := fmt.Sprintf("MERGE INTO %s AS target USING (VALUES %s) AS source (%s) ON %s",
+		entity, strings.Join(valueGroups, ", "), strings.Join(cols, ", "), strings.Join(onConds, " AND "))
+
+	// Matching the rest of the package's upsert dialects: doNothing or no
+	// updates given means matched rows are left untouched, so WHEN
+	// MATCHED is omitted entirely.
+	if !doNothing && len(updates) > 0 {
+		sets := make([]string, len(updates))
+		for i, u := range updates {
+			expr := u.Expr
+			if expr == "" {
+				expr = "source." + u.Column
+			}
+			sets[i] = fmt.Sprintf("%s = %s", u.Column, expr)
+		}
+		stmt += " WHEN MATCHED THEN UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	sourceVals := make([]string, len(cols))
+	for i, c := range cols {
+		sourceVals[i] = "source." + c
+	}
+	stmt += fmt.Sprintf(" WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)", strings.Join(cols, ", "), strings.Join(sourceVals, ", "))
+	stmt += ";"
+	return stmt
+}
@@ -0,0 +1,64 @@
+package goquery
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var mysqlDialect = DbDialect{
+	TableExistsStmt: "SHOW TABLES LIKE ?",
+	Bind: func(field string, i int) string {
+		return "?"
+	},
+	QuoteIdent:  func(ident string) string { return "`" + ident + "`" },
+	LimitOffset: standardLimitOffset,
+	ConflictClause: func(conflictCols []string, doNothing bool, updates []ConflictUpdate) string {
+		if doNothing || len(updates) == 0 {
+			// MySQL has no DO NOTHING; reassigning the conflicting column
+			// to itself is the conventional no-op.
+			col := "id"
+			if len(conflictCols) > 0 {
+				col = conflictCols[0]
+			}
+			return fmt.Sprintf("ON DUPLICATE KEY UPDATE %s = %s", col, col)
+		}
+		sets := make([]string, len(updates))
+		for i, u := range updates {
+			expr := u.Expr
+			if expr == "" {
+				expr = fmt.Sprintf("VALUES(%s)", u.Column)
+			}
+			sets[i] = fmt.Sprintf("%s = %s", u.Column, expr)
+		}
+		return "ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	},
+	// SupportsReturning is false: MySQL has no RETURNING clause, so
+	// FluentInsert.Returning falls back to LastInsertId() + a SELECT.
+	SupportsReturning: false,
+	SqlType: func(t reflect.Type, tag columnTag) string {
+		switch {
+		case t == reflect.TypeOf(time.Time{}):
+			return "DATETIME"
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+			return "BLOB"
+		}
+		switch t.Kind() {
+		case reflect.Int64:
+			return "BIGINT"
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+			return "INT"
+		case reflect.Float32, reflect.Float64:
+			return "DOUBLE"
+		case reflect.Bool:
+			return "BOOLEAN"
+		default:
+			return "VARCHAR(255)"
+		}
+	},
+	AutoIncrementKeyword: "AUTO_INCREMENT",
+	ColumnsStmt: func(table string) string {
+		return fmt.Sprintf("SELECT column_name FROM information_schema.columns WHERE table_name = '%s'", lastPathSegment(table))
+	},
+}
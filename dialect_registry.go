@@ -0,0 +1,50 @@
+package goquery
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DialectRegistry looks up a DbDialect by the short name callers use to
+// select a backend (e.g. "postgres", "mysql"). Safe for concurrent
+// Register/Get, since Dialects is a shared package-level instance callers
+// may register custom dialects on at any time.
+type DialectRegistry struct {
+	mu       sync.RWMutex
+	dialects map[string]DbDialect
+}
+
+// NewDialectRegistry returns a registry pre-populated with goquery's
+// built-in dialects: duckdb, postgres, mysql, sqlite, and mssql.
+func NewDialectRegistry() *DialectRegistry {
+	r := &DialectRegistry{dialects: make(map[string]DbDialect)}
+	r.Register("duckdb", duckdbDialect)
+	r.Register("postgres", postgresDialect)
+	r.Register("mysql", mysqlDialect)
+	r.Register("sqlite", sqliteDialect)
+	r.Register("mssql", mssqlDialect)
+	return r
+}
+
+// Register adds or replaces the dialect known by name.
+func (r *DialectRegistry) Register(name string, d DbDialect) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dialects[name] = d
+}
+
+// Get looks up the dialect registered under name.
+func (r *DialectRegistry) Get(name string) (DbDialect, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, ok := r.dialects[name]
+	if !ok {
+		return DbDialect{}, fmt.Errorf("goquery: no dialect registered as %q", name)
+	}
+	return d, nil
+}
+
+// Dialects is the registry consulted by name, for example by
+// NewDataStore. Callers can Register a custom dialect on it, or build
+// their own DialectRegistry entirely.
+var Dialects = NewDialectRegistry()
@@ -0,0 +1,52 @@
+package goquery
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var sqliteDialect = DbDialect{
+	TableExistsStmt: `SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = ?)`,
+	Bind: func(field string, i int) string {
+		return "?"
+	},
+	QuoteIdent:     doubleQuoteIdent,
+	LimitOffset:    standardLimitOffset,
+	ConflictClause: excludedStyleConflictClause,
+	// SupportsReturning requires SQLite 3.35+; callers on older SQLite
+	// should register their own dialect with this set to false.
+	SupportsReturning: true,
+	ReturningClause: func(cols []string) string {
+		return "RETURNING " + strings.Join(cols, ", ")
+	},
+	SqlType: func(t reflect.Type, tag columnTag) string {
+		if tag.AutoIncrement && isIntKind(t.Kind()) {
+			return "INTEGER"
+		}
+		switch {
+		case t == reflect.TypeOf(time.Time{}):
+			return "TIMESTAMP"
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+			return "BLOB"
+		}
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return "INTEGER"
+		case reflect.Float32, reflect.Float64:
+			return "REAL"
+		case reflect.Bool:
+			return "BOOLEAN"
+		default:
+			return "TEXT"
+		}
+	},
+	// AutoIncrementKeyword assumes the column is also tagged `pk`, so the
+	// rendered definition reads "INTEGER PRIMARY KEY AUTOINCREMENT" as
+	// SQLite requires.
+	AutoIncrementKeyword: "AUTOINCREMENT",
+	ColumnsStmt: func(table string) string {
+		return fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table)
+	},
+}
@@ -0,0 +1,83 @@
+package goquery
+
+import "testing"
+
+func TestDialectBind(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect DbDialect
+		i       int
+		want    string
+	}{
+		{"postgres", postgresDialect, 0, "$1"},
+		{"postgres", postgresDialect, 2, "$3"},
+		{"mysql", mysqlDialect, 2, "?"},
+		{"sqlite", sqliteDialect, 2, "?"},
+		{"mssql", mssqlDialect, 0, "@p1"},
+	}
+	for _, c := range cases {
+		if got := c.dialect.Bind("col", c.i); got != c.want {
+			t.Errorf("%s.Bind(%d) = %q, want %q", c.name, c.i, got, c.want)
+		}
+	}
+}
+
+func TestDialectQuoteIdent(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect DbDialect
+		want    string
+	}{
+		{"postgres", postgresDialect, `"col"`},
+		{"mysql", mysqlDialect, "`col`"},
+		{"mssql", mssqlDialect, "[col]"},
+	}
+	for _, c := range cases {
+		if got := c.dialect.QuoteIdent("col"); got != c.want {
+			t.Errorf("%s.QuoteIdent = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestDialectLimitOffset(t *testing.T) {
+	if got := postgresDialect.LimitOffset(10, 20); got != "LIMIT 10 OFFSET 20" {
+		t.Errorf("postgres LimitOffset = %q", got)
+	}
+	if got := mssqlDialect.LimitOffset(10, 20); got != "OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY" {
+		t.Errorf("mssql LimitOffset = %q", got)
+	}
+}
+
+func TestExcludedStyleConflictClause(t *testing.T) {
+	doNothing := excludedStyleConflictClause([]string{"id"}, true, nil)
+	if want := "ON CONFLICT (id) DO NOTHING"; doNothing != want {
+		t.Errorf("doNothing clause = %q, want %q", doNothing, want)
+	}
+
+	update := excludedStyleConflictClause([]string{"id"}, false, []ConflictUpdate{{Column: "name"}})
+	if want := "ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name"; update != want {
+		t.Errorf("update clause = %q, want %q", update, want)
+	}
+}
+
+func TestMySQLConflictClauseDoNothing(t *testing.T) {
+	got := mysqlDialect.ConflictClause([]string{"id"}, true, nil)
+	if want := "ON DUPLICATE KEY UPDATE id = id"; got != want {
+		t.Errorf("mysql doNothing clause = %q, want %q", got, want)
+	}
+}
+
+func TestMSSQLMergeUpsertStmt(t *testing.T) {
+	cols := []string{"id", "name"}
+	valueGroups := []string{"(@p1, @p2)"}
+
+	doNothing := mssqlMergeUpsertStmt("users", cols, valueGroups, []string{"id"}, true, nil)
+	if want := "MERGE INTO users AS target USING (VALUES (@p1, @p2)) AS source (id, name) ON target.id = source.id WHEN NOT MATCHED THEN INSERT (id, name) VALUES (source.id, source.name);"; doNothing != want {
+		t.Errorf("doNothing merge = %q, want %q", doNothing, want)
+	}
+
+	update := mssqlMergeUpsertStmt("users", cols, valueGroups, []string{"id"}, false, []ConflictUpdate{{Column: "name"}})
+	if want := "MERGE INTO users AS target USING (VALUES (@p1, @p2)) AS source (id, name) ON target.id = source.id WHEN MATCHED THEN UPDATE SET name = source.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (source.id, source.name);"; update != want {
+		t.Errorf("update merge = %q, want %q", update, want)
+	}
+}
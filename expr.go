@@ -0,0 +1,129 @@
+package goquery
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Expression is a composable SQL predicate or value, rendered through the
+// active DbDialect so placeholder style and identifier quoting stay in one
+// place. Build them with C (a column reference), V (a literal), and the
+// comparison/boolean helpers.
+type Expression interface {
+	// render appends this expression's SQL text and bound args, numbering
+	// placeholders starting at argBase (the count of args already bound
+	// earlier in the same statement).
+	render(dialect DbDialect, argBase int) (sqlText string, args []interface{})
+}
+
+// column is a column reference, the left-hand side of comparisons like
+// C("age").Eq(18).
+type column struct {
+	name string
+}
+
+// C refers to a column by name.
+func C(name string) column {
+	return column{name: name}
+}
+
+func (c column) render(dialect DbDialect, argBase int) (string, []interface{}) {
+	return quoteQualifiedIdent(dialect, c.name), nil
+}
+
+func (c column) Eq(v interface{}) Expression   { return comparison{left: c, op: "=", right: toExpr(v)} }
+func (c column) Neq(v interface{}) Expression  { return comparison{left: c, op: "<>", right: toExpr(v)} }
+func (c column) Gt(v interface{}) Expression   { return comparison{left: c, op: ">", right: toExpr(v)} }
+func (c column) Gte(v interface{}) Expression  { return comparison{left: c, op: ">=", right: toExpr(v)} }
+func (c column) Lt(v interface{}) Expression   { return comparison{left: c, op: "<", right: toExpr(v)} }
+func (c column) Lte(v interface{}) Expression  { return comparison{left: c, op: "<=", right: toExpr(v)} }
+func (c column) Like(pattern string) Expression {
+	return comparison{left: c, op: "LIKE", right: V(pattern)}
+}
+func (c column) In(values ...interface{}) Expression {
+	return inClause{col: c, values: values}
+}
+
+// V wraps a literal value so it can appear anywhere an Expression can,
+// including the left-hand side of a comparison.
+func V(value interface{}) literal {
+	return literal{value: value}
+}
+
+type literal struct {
+	value interface{}
+}
+
+func (l literal) Eq(v interface{}) Expression  { return comparison{left: l, op: "=", right: toExpr(v)} }
+func (l literal) Neq(v interface{}) Expression { return comparison{left: l, op: "<>", right: toExpr(v)} }
+func (l literal) Gt(v interface{}) Expression  { return comparison{left: l, op: ">", right: toExpr(v)} }
+func (l literal) Gte(v interface{}) Expression { return comparison{left: l, op: ">=", right: toExpr(v)} }
+func (l literal) Lt(v interface{}) Expression  { return comparison{left: l, op: "<", right: toExpr(v)} }
+func (l literal) Lte(v interface{}) Expression { return comparison{left: l, op: "<=", right: toExpr(v)} }
+
+func (l literal) render(dialect DbDialect, argBase int) (string, []interface{}) {
+	return dialect.Bind("", argBase), []interface{}{l.value}
+}
+
+func toExpr(v interface{}) Expression {
+	if e, ok := v.(Expression); ok {
+		return e
+	}
+	return V(v)
+}
+
+type comparison struct {
+	left  Expression
+	op    string
+	right Expression
+}
+
+func (c comparison) render(dialect DbDialect, argBase int) (string, []interface{}) {
+	leftSQL, leftArgs := c.left.render(dialect, argBase)
+	rightSQL, rightArgs := c.right.render(dialect, argBase+len(leftArgs))
+	args := append(leftArgs, rightArgs...)
+	return fmt.Sprintf("%s %s %s", leftSQL, c.op, rightSQL), args
+}
+
+type inClause struct {
+	col    column
+	values []interface{}
+}
+
+func (ic inClause) render(dialect DbDialect, argBase int) (string, []interface{}) {
+	if len(ic.values) == 0 {
+		// "col IN ()" is a syntax error on every dialect; "IN (<empty
+		// set>)" is always false, so render that directly instead.
+		return "1 = 0", nil
+	}
+	colSQL, _ := ic.col.render(dialect, argBase)
+	placeholders := make([]string, len(ic.values))
+	for i := range ic.values {
+		placeholders[i] = dialect.Bind(ic.col.name, argBase+i)
+	}
+	return fmt.Sprintf("%s IN (%s)", colSQL, strings.Join(placeholders, ", ")), ic.values
+}
+
+type boolGroup struct {
+	op    string
+	exprs []Expression
+}
+
+// And combines expressions with AND, parenthesized as a single group.
+func And(exprs ...Expression) Expression { return boolGroup{op: "AND", exprs: exprs} }
+
+// Or combines expressions with OR, parenthesized as a single group.
+func Or(exprs ...Expression) Expression { return boolGroup{op: "OR", exprs: exprs} }
+
+func (g boolGroup) render(dialect DbDialect, argBase int) (string, []interface{}) {
+	parts := make([]string, len(g.exprs))
+	var args []interface{}
+	base := argBase
+	for i, e := range g.exprs {
+		s, a := e.render(dialect, base)
+		parts[i] = s
+		args = append(args, a...)
+		base += len(a)
+	}
+	return "(" + strings.Join(parts, " "+g.op+" ") + ")", args
+}
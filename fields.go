@@ -0,0 +1,59 @@
+package goquery
+
+import (
+	"reflect"
+	"strings"
+)
+
+// fieldInfo describes one column of a DataSet's TableFields struct, as
+// derived from its `db`, `fake`, and `goquery` struct tags.
+type fieldInfo struct {
+	Name       string // Go struct field name
+	Column     string // db column name
+	Index      int    // index into the struct's fields
+	Type       reflect.Type
+	FakeTag    string // raw `fake:"..."` tag value, empty if absent
+	GoqueryTag string // raw `goquery:"..."` tag value, empty if absent
+}
+
+// fieldsOf reflects over ds.Fields() (a zero-value TableFields struct) and
+// returns one fieldInfo per exported field.
+func fieldsOf(ds DataSet) []fieldInfo {
+	typ := reflect.TypeOf(ds.Fields())
+	fields := make([]fieldInfo, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if sf.PkgPath != "" { // unexported
+			continue
+		}
+		col := sf.Tag.Get("db")
+		if col == "" {
+			col = strings.ToLower(sf.Name)
+		}
+		fields = append(fields, fieldInfo{
+			Name:       sf.Name,
+			Column:     col,
+			Index:      i,
+			Type:       sf.Type,
+			FakeTag:    sf.Tag.Get("fake"),
+			GoqueryTag: sf.Tag.Get("goquery"),
+		})
+	}
+	return fields
+}
+
+// filterColumns narrows fields down to the named columns, preserving the
+// order of cols rather than the order of fields.
+func filterColumns(fields []fieldInfo, cols []string) []fieldInfo {
+	byColumn := make(map[string]fieldInfo, len(fields))
+	for _, f := range fields {
+		byColumn[f.Column] = f
+	}
+	out := make([]fieldInfo, 0, len(cols))
+	for _, c := range cols {
+		if f, ok := byColumn[c]; ok {
+			out = append(out, f)
+		}
+	}
+	return out
+}
@@ -1,18 +1,36 @@
 package goquery
 
+import (
+	"context"
+	"sort"
+)
+
 type FluentInsert struct {
-	store      DataStore
-	ds         DataSet
-	batch      bool
-	batchSize  int
-	tx         *Tx
-	records    interface{}
-	panicOnErr bool
-	//return id
+	store             *DataStore
+	ds                DataSet
+	ctx               context.Context
+	batch             bool
+	batchSize         int
+	tx                *Tx
+	records           interface{}
+	panicOnErr        bool
+	columns           []string
+	conflictCols      []string
+	conflictDoNothing bool
+	conflictUpdates   []ConflictUpdate
+	returning         []string
+	dest              interface{}
 }
 
 const defaultBatchSize = 100
 
+// Context sets the context propagated to the underlying *sql.DB calls and
+// to every registered QueryHook. Defaults to context.Background().
+func (i *FluentInsert) Context(ctx context.Context) *FluentInsert {
+	i.ctx = ctx
+	return i
+}
+
 func (i *FluentInsert) Tx(tx *Tx) *FluentInsert {
 	i.tx = tx
 	return i
@@ -38,14 +56,83 @@ func (i *FluentInsert) PanicOnErr(panicOnErr bool) *FluentInsert {
 	return i
 }
 
+// OnConflict names the columns (typically a unique index or primary key)
+// that identify a conflicting row. It must be paired with DoNothing,
+// DoUpdate, or DoUpdateExpr to say what happens on a conflict.
+func (i *FluentInsert) OnConflict(cols ...string) *FluentInsert {
+	i.conflictCols = cols
+	return i
+}
+
+// DoNothing makes a conflicting row a no-op (e.g. ON CONFLICT DO NOTHING).
+func (i *FluentInsert) DoNothing() *FluentInsert {
+	i.conflictDoNothing = true
+	i.conflictUpdates = nil
+	return i
+}
+
+// DoUpdate upserts setCols on conflict, assigning each the value that was
+// about to be inserted (e.g. `col = EXCLUDED.col` on Postgres).
+func (i *FluentInsert) DoUpdate(setCols ...string) *FluentInsert {
+	i.conflictDoNothing = false
+	updates := make([]ConflictUpdate, len(setCols))
+	for idx, c := range setCols {
+		updates[idx] = ConflictUpdate{Column: c}
+	}
+	i.conflictUpdates = updates
+	return i
+}
+
+// DoUpdateExpr upserts on conflict like DoUpdate, but assigns each column
+// the given raw SQL expression instead of the inserted value.
+func (i *FluentInsert) DoUpdateExpr(exprs map[string]string) *FluentInsert {
+	i.conflictDoNothing = false
+	cols := make([]string, 0, len(exprs))
+	for c := range exprs {
+		cols = append(cols, c)
+	}
+	sort.Strings(cols)
+	updates := make([]ConflictUpdate, len(cols))
+	for idx, c := range cols {
+		updates[idx] = ConflictUpdate{Column: c, Expr: exprs[c]}
+	}
+	i.conflictUpdates = updates
+	return i
+}
+
+// Returning specifies which columns of the inserted row(s) should be read
+// back after Execute: a RETURNING clause on dialects that support it, or a
+// LastInsertId()-keyed follow-up SELECT otherwise. Requires Into.
+func (i *FluentInsert) Returning(cols ...string) *FluentInsert {
+	i.returning = cols
+	return i
+}
+
+// Into sets the destination that Returning values are scanned into: a
+// pointer to a slice of structs whose `db`-tagged fields match the
+// Returning columns.
+func (i *FluentInsert) Into(dest interface{}) *FluentInsert {
+	i.dest = dest
+	return i
+}
+
 func (i *FluentInsert) Execute() error {
-	//return i.store.InsertRecs(i.ds, i.records, i.batch, i.batchSize, i.tx)
 	ii := InsertInput{
-		Dataset:    i.ds,
-		Records:    i.records,
-		Batch:      i.batch,
-		BatchSize:  i.batchSize,
-		PanicOnErr: i.panicOnErr,
+		Dataset:           i.ds,
+		Records:           i.records,
+		Batch:             i.batch,
+		BatchSize:         i.batchSize,
+		PanicOnErr:        i.panicOnErr,
+		Columns:           i.columns,
+		OnConflictCols:    i.conflictCols,
+		ConflictDoNothing: i.conflictDoNothing,
+		ConflictUpdates:   i.conflictUpdates,
+		Returning:         i.returning,
+		Dest:              i.dest,
+	}
+	ctx := i.ctx
+	if ctx == nil {
+		ctx = context.Background()
 	}
-	return i.store.InsertRecs(i.tx, ii)
+	return i.store.InsertRecs(ctx, i.tx, ii)
 }
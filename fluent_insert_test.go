@@ -0,0 +1,116 @@
+package goquery
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type insertTestRow struct {
+	ID   int64  `db:"id"`
+	Name string `db:"name"`
+}
+
+func insertTestBatch(rows ...insertTestRow) reflect.Value {
+	return reflect.ValueOf(rows)
+}
+
+func TestBuildInsertStmtPlain(t *testing.T) {
+	ds := &TableDataSet{Name: "users", TableFields: insertTestRow{}}
+	fields := fieldsOf(ds)
+	in := InsertInput{Dataset: ds}
+
+	stmt, args, err := buildInsertStmt(postgresDialect, in, fields, insertTestBatch(insertTestRow{ID: 1, Name: "ada"}))
+	if err != nil {
+		t.Fatalf("buildInsertStmt: %v", err)
+	}
+	if want := "INSERT INTO users (id, name) VALUES ($1, $2)"; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+	if want := []interface{}{int64(1), "ada"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestBuildInsertStmtOnConflictDoNothing(t *testing.T) {
+	ds := &TableDataSet{Name: "users", TableFields: insertTestRow{}}
+	fields := fieldsOf(ds)
+	in := InsertInput{Dataset: ds, OnConflictCols: []string{"id"}, ConflictDoNothing: true}
+
+	stmt, _, err := buildInsertStmt(postgresDialect, in, fields, insertTestBatch(insertTestRow{ID: 1, Name: "ada"}))
+	if err != nil {
+		t.Fatalf("buildInsertStmt: %v", err)
+	}
+	if want := "INSERT INTO users (id, name) VALUES ($1, $2) ON CONFLICT (id) DO NOTHING"; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+}
+
+func TestBuildInsertStmtOnConflictDoUpdate(t *testing.T) {
+	ds := &TableDataSet{Name: "users", TableFields: insertTestRow{}}
+	fields := fieldsOf(ds)
+	in := InsertInput{
+		Dataset:         ds,
+		OnConflictCols:  []string{"id"},
+		ConflictUpdates: []ConflictUpdate{{Column: "name"}},
+	}
+
+	stmt, _, err := buildInsertStmt(mysqlDialect, in, fields, insertTestBatch(insertTestRow{ID: 1, Name: "ada"}))
+	if err != nil {
+		t.Fatalf("buildInsertStmt: %v", err)
+	}
+	if want := "INSERT INTO users (id, name) VALUES (?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name)"; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+}
+
+func TestBuildInsertStmtMSSQLUpsertUsesMerge(t *testing.T) {
+	ds := &TableDataSet{Name: "users", TableFields: insertTestRow{}}
+	fields := fieldsOf(ds)
+	in := InsertInput{Dataset: ds, OnConflictCols: []string{"id"}, ConflictDoNothing: true}
+
+	stmt, args, err := buildInsertStmt(mssqlDialect, in, fields, insertTestBatch(insertTestRow{ID: 1, Name: "ada"}))
+	if err != nil {
+		t.Fatalf("buildInsertStmt: %v", err)
+	}
+	if !strings.HasPrefix(stmt, "MERGE INTO users") {
+		t.Errorf("stmt = %q, want MERGE statement", stmt)
+	}
+	if want := []interface{}{int64(1), "ada"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestBuildInsertStmtNoUpsertSupportErrors(t *testing.T) {
+	ds := &TableDataSet{Name: "users", TableFields: insertTestRow{}}
+	fields := fieldsOf(ds)
+	noUpsert := postgresDialect
+	noUpsert.ConflictClause = nil
+	noUpsert.MergeUpsertStmt = nil
+	in := InsertInput{Dataset: ds, OnConflictCols: []string{"id"}, ConflictDoNothing: true}
+
+	if _, _, err := buildInsertStmt(noUpsert, in, fields, insertTestBatch(insertTestRow{ID: 1, Name: "ada"})); err == nil {
+		t.Error("expected an error for a dialect with no upsert support")
+	}
+}
+
+func TestInsertBatchReturningRejectsOnConflictWithoutReturningSupport(t *testing.T) {
+	store := &DataStore{Dialect: mssqlDialect}
+	ds := &TableDataSet{Name: "users", TableFields: insertTestRow{}}
+	fields := fieldsOf(ds)
+	var dest []insertTestRow
+
+	in := InsertInput{
+		Dataset:           ds,
+		OnConflictCols:    []string{"id"},
+		ConflictDoNothing: true,
+		Returning:         []string{"id"},
+		Dest:              &dest,
+	}
+
+	err := store.insertBatchReturning(context.Background(), nil, in, fields, insertTestBatch(insertTestRow{ID: 1, Name: "ada"}))
+	if err == nil {
+		t.Fatal("expected an error combining Returning with OnConflict on a dialect without RETURNING support")
+	}
+}
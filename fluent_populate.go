@@ -0,0 +1,234 @@
+package goquery
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FluentPopulate generates and inserts N synthetic rows for a DataSet,
+// without requiring the caller to hand-author records. Column values are
+// derived from each TableFields field's Go type, with `fake:"..."` struct
+// tags giving finer control. Generation is streamed through FluentInsert in
+// batch mode, so large N (e.g. 10M rows) never materializes in memory all
+// at once.
+type FluentPopulate struct {
+	store      *DataStore
+	ds         DataSet
+	n          int
+	seed       int64
+	batchSize  int
+	tx         *Tx
+	generators map[string]func() any
+}
+
+// Populate begins building a fake-data population of ds.
+func (d *DataStore) Populate(ds DataSet) *FluentPopulate {
+	return &FluentPopulate{store: d, ds: ds, batchSize: defaultBatchSize}
+}
+
+// N sets how many rows to generate.
+func (p *FluentPopulate) N(n int) *FluentPopulate {
+	p.n = n
+	return p
+}
+
+// Seed fixes the random seed so runs are reproducible. Left at zero, the
+// current time is used.
+func (p *FluentPopulate) Seed(seed int64) *FluentPopulate {
+	p.seed = seed
+	return p
+}
+
+// BatchSize controls how many generated rows are inserted per underlying
+// FluentInsert call.
+func (p *FluentPopulate) BatchSize(bs int) *FluentPopulate {
+	p.batchSize = bs
+	return p
+}
+
+func (p *FluentPopulate) Tx(tx *Tx) *FluentPopulate {
+	p.tx = tx
+	return p
+}
+
+// Generators overrides the fake-data generator for specific columns by
+// name, taking precedence over both named `fake` tags and the Go-type
+// defaults. A column tagged `fake:"skip"` is still skipped.
+func (p *FluentPopulate) Generators(g map[string]func() any) *FluentPopulate {
+	p.generators = g
+	return p
+}
+
+// Execute generates p.n rows and inserts them in batches of p.batchSize.
+func (p *FluentPopulate) Execute() error {
+	if p.n <= 0 {
+		return nil
+	}
+
+	seed := p.seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rnd := rand.New(rand.NewSource(seed))
+
+	typ := reflect.TypeOf(p.ds.Fields())
+	fields, gens := p.resolveGenerators(rnd, fieldsOf(p.ds))
+	cols := make([]string, len(fields))
+	for i, f := range fields {
+		cols[i] = f.Column
+	}
+
+	batchSize := p.batchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	for remaining := p.n; remaining > 0; {
+		n := batchSize
+		if n > remaining {
+			n = remaining
+		}
+
+		batch := reflect.MakeSlice(reflect.SliceOf(typ), n, n)
+		for r := 0; r < n; r++ {
+			rec := batch.Index(r)
+			for _, f := range fields {
+				val := reflect.ValueOf(gens[f.Column]())
+				rec.Field(f.Index).Set(val.Convert(rec.Field(f.Index).Type()))
+			}
+		}
+
+		insert := p.store.Insert(p.ds).Records(batch.Interface()).Batch(true).BatchSize(n).Tx(p.tx)
+		insert.columns = cols
+		if err := insert.Execute(); err != nil {
+			return err
+		}
+		remaining -= n
+	}
+	return nil
+}
+
+// resolveGenerators drops `fake:"skip"` fields and pairs every remaining
+// field with a generator func, preferring a caller-supplied Generators
+// override, then a named `fake` tag, then a default for the field's Go
+// type.
+func (p *FluentPopulate) resolveGenerators(rnd *rand.Rand, fields []fieldInfo) ([]fieldInfo, map[string]func() any) {
+	active := make([]fieldInfo, 0, len(fields))
+	gens := make(map[string]func() any, len(fields))
+	for _, f := range fields {
+		if f.FakeTag == "skip" {
+			continue
+		}
+		if custom, ok := p.generators[f.Column]; ok {
+			gens[f.Column] = custom
+		} else {
+			gens[f.Column] = fakeGeneratorFor(rnd, f)
+		}
+		active = append(active, f)
+	}
+	return active, gens
+}
+
+var fakeFirstNames = []string{"James", "Mary", "John", "Patricia", "Robert", "Jennifer", "Michael", "Linda", "William", "Elizabeth"}
+var fakeLastNames = []string{"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis", "Rodriguez", "Martinez"}
+var fakeWords = []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel", "india", "juliet"}
+
+// namedFakeGenerators resolve a `fake:"{name[:args]}"` tag to a generator.
+var namedFakeGenerators = map[string]func(rnd *rand.Rand, args []string) any{
+	"firstname": func(rnd *rand.Rand, _ []string) any { return fakeFirstNames[rnd.Intn(len(fakeFirstNames))] },
+	"lastname":  func(rnd *rand.Rand, _ []string) any { return fakeLastNames[rnd.Intn(len(fakeLastNames))] },
+	"word":      func(rnd *rand.Rand, _ []string) any { return fakeWords[rnd.Intn(len(fakeWords))] },
+	"email": func(rnd *rand.Rand, _ []string) any {
+		first := fakeFirstNames[rnd.Intn(len(fakeFirstNames))]
+		last := fakeLastNames[rnd.Intn(len(fakeLastNames))]
+		return fmt.Sprintf("%s.%s@example.com", strings.ToLower(first), strings.ToLower(last))
+	},
+	"int": func(rnd *rand.Rand, args []string) any {
+		lo, hi := parseIntRange(args, 1, 100)
+		if hi <= lo {
+			return lo
+		}
+		return lo + rnd.Intn(hi-lo+1)
+	},
+	"float": func(rnd *rand.Rand, args []string) any {
+		lo, hi := 0.0, 100.0
+		if len(args) == 2 {
+			lo, _ = strconv.ParseFloat(args[0], 64)
+			hi, _ = strconv.ParseFloat(args[1], 64)
+		}
+		return lo + rnd.Float64()*(hi-lo)
+	},
+}
+
+func parseIntRange(args []string, defaultLo, defaultHi int) (int, int) {
+	lo, hi := defaultLo, defaultHi
+	if len(args) == 2 {
+		if v, err := strconv.Atoi(strings.TrimSpace(args[0])); err == nil {
+			lo = v
+		}
+		if v, err := strconv.Atoi(strings.TrimSpace(args[1])); err == nil {
+			hi = v
+		}
+	}
+	return lo, hi
+}
+
+// fakeGeneratorFor resolves a field's generator from its `fake` tag, or
+// falls back to a default for its Go type.
+func fakeGeneratorFor(rnd *rand.Rand, f fieldInfo) func() any {
+	if f.FakeTag != "" {
+		name, args := parseFakeTag(f.FakeTag)
+		if gen, ok := namedFakeGenerators[name]; ok {
+			return func() any { return gen(rnd, args) }
+		}
+	}
+	return defaultFakeGenerator(rnd, f.Type)
+}
+
+// parseFakeTag splits a `fake:"{name:arg,arg}"` tag into its name and args.
+func parseFakeTag(tag string) (string, []string) {
+	tag = strings.TrimPrefix(tag, "{")
+	tag = strings.TrimSuffix(tag, "}")
+	parts := strings.SplitN(tag, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+	return parts[0], strings.Split(parts[1], ",")
+}
+
+// defaultFakeGenerator picks a sensible generator for a Go type with no
+// `fake` tag: string->word, int/int64->random int, float->random float,
+// time.Time->random timestamp within the last year, []byte->random bytes,
+// bool->random bool.
+func defaultFakeGenerator(rnd *rand.Rand, typ reflect.Type) func() any {
+	switch {
+	case typ == reflect.TypeOf(time.Time{}):
+		return func() any {
+			window := int64(365 * 24 * time.Hour)
+			return time.Now().Add(-time.Duration(rnd.Int63n(window)))
+		}
+	case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
+		return func() any {
+			b := make([]byte, 16)
+			rnd.Read(b)
+			return b
+		}
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return func() any { return fakeWords[rnd.Intn(len(fakeWords))] }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func() any { return rnd.Intn(1000) }
+	case reflect.Float32, reflect.Float64:
+		return func() any { return rnd.Float64() * 1000 }
+	case reflect.Bool:
+		return func() any { return rnd.Intn(2) == 1 }
+	default:
+		return func() any { return reflect.Zero(typ).Interface() }
+	}
+}
@@ -0,0 +1,168 @@
+package goquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// joinClause pairs a joined DataSet with the Expression its ON clause
+// renders from. Shared by FluentSelect and FluentUpdate.
+type joinClause struct {
+	ds   DataSet
+	on   Expression
+	kind string
+}
+
+// FluentSelect builds and runs a SELECT against a DataSet, rendering
+// Columns/Where/Join/OrderBy/GroupBy/Having/Limit/Offset through the
+// active DbDialect. Obtain one from DataStore.Select.
+type FluentSelect struct {
+	store *DataStore
+	ds    DataSet
+	ctx   context.Context
+	tx    *Tx
+
+	columns   []string
+	where     Expression
+	joins     []joinClause
+	orderBy   []string
+	groupBy   []string
+	having    Expression
+	limit     int
+	hasLimit  bool
+	offset    int
+	forUpdate bool
+}
+
+// Select begins building a FluentSelect against ds.
+func (d *DataStore) Select(ds DataSet) *FluentSelect {
+	return &FluentSelect{store: d, ds: ds}
+}
+
+// Context sets the context propagated to the underlying *sql.DB calls and
+// to every registered QueryHook. Defaults to context.Background().
+func (s *FluentSelect) Context(ctx context.Context) *FluentSelect { s.ctx = ctx; return s }
+
+func (s *FluentSelect) Tx(tx *Tx) *FluentSelect { s.tx = tx; return s }
+
+// Columns restricts which columns are selected. Unset selects every
+// column ("SELECT *").
+func (s *FluentSelect) Columns(cols ...string) *FluentSelect { s.columns = cols; return s }
+
+func (s *FluentSelect) Where(expr Expression) *FluentSelect { s.where = expr; return s }
+
+// Join adds an inner join against ds, rendering on as its ON clause.
+func (s *FluentSelect) Join(ds DataSet, on Expression) *FluentSelect {
+	s.joins = append(s.joins, joinClause{ds: ds, on: on, kind: "JOIN"})
+	return s
+}
+
+func (s *FluentSelect) OrderBy(cols ...string) *FluentSelect { s.orderBy = cols; return s }
+
+func (s *FluentSelect) GroupBy(cols ...string) *FluentSelect { s.groupBy = cols; return s }
+
+func (s *FluentSelect) Having(expr Expression) *FluentSelect { s.having = expr; return s }
+
+func (s *FluentSelect) Limit(n int) *FluentSelect { s.limit = n; s.hasLimit = true; return s }
+
+func (s *FluentSelect) Offset(n int) *FluentSelect { s.offset = n; return s }
+
+// ForUpdate appends a FOR UPDATE row-locking clause.
+func (s *FluentSelect) ForUpdate() *FluentSelect { s.forUpdate = true; return s }
+
+func (s *FluentSelect) ctxOrBackground() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+func (s *FluentSelect) build() (string, []interface{}) {
+	dialect := s.store.Dialect
+
+	cols := "*"
+	if len(s.columns) > 0 {
+		cols = strings.Join(s.columns, ", ")
+	}
+	stmt := fmt.Sprintf("SELECT %s FROM %s", cols, s.ds.Entity())
+
+	var args []interface{}
+	for _, j := range s.joins {
+		onSQL, onArgs := j.on.render(dialect, len(args))
+		args = append(args, onArgs...)
+		stmt += fmt.Sprintf(" %s %s ON %s", j.kind, j.ds.Entity(), onSQL)
+	}
+	if s.where != nil {
+		whereSQL, whereArgs := s.where.render(dialect, len(args))
+		args = append(args, whereArgs...)
+		stmt += " WHERE " + whereSQL
+	}
+	if len(s.groupBy) > 0 {
+		stmt += " GROUP BY " + strings.Join(s.groupBy, ", ")
+	}
+	if s.having != nil {
+		havingSQL, havingArgs := s.having.render(dialect, len(args))
+		args = append(args, havingArgs...)
+		stmt += " HAVING " + havingSQL
+	}
+	if len(s.orderBy) > 0 {
+		stmt += " ORDER BY " + strings.Join(s.orderBy, ", ")
+	}
+	if s.hasLimit {
+		limitOffset := fmt.Sprintf("LIMIT %d OFFSET %d", s.limit, s.offset)
+		if dialect.LimitOffset != nil {
+			limitOffset = dialect.LimitOffset(s.limit, s.offset)
+		}
+		stmt += " " + limitOffset
+	}
+	if s.forUpdate {
+		stmt += " FOR UPDATE"
+	}
+	return stmt, args
+}
+
+// Scan runs the built SELECT and appends every row into dest, a pointer
+// to a slice of structs, matching columns to `db`-tagged fields.
+func (s *FluentSelect) Scan(dest any) error {
+	destPtr := reflect.ValueOf(dest)
+	if destPtr.Kind() != reflect.Ptr || destPtr.Elem().Kind() != reflect.Slice {
+		return errors.New("goquery: Scan requires a pointer to a slice")
+	}
+	destSlice := destPtr.Elem()
+
+	stmt, args := s.build()
+	return s.store.runHooks(s.ctxOrBackground(), OpSelect, s.ds.Entity(), stmt, args, func(ctx context.Context) (int64, error) {
+		rows, err := s.store.query(ctx, s.tx, stmt, args)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		cols, err := rows.Columns()
+		if err != nil {
+			return 0, err
+		}
+		return scanRowsInto(rows, cols, destSlice)
+	})
+}
+
+// Iterate runs the built SELECT and calls fn once with the resulting
+// Rows, for callers that want to stream results rather than materialize
+// them all at once.
+func (s *FluentSelect) Iterate(fn RowFunction) error {
+	stmt, args := s.build()
+	return s.store.runHooks(s.ctxOrBackground(), OpSelect, s.ds.Entity(), stmt, args, func(ctx context.Context) (int64, error) {
+		rows, err := s.store.query(ctx, s.tx, stmt, args)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		adapter, err := newSQLRowsAdapter(rows)
+		if err != nil {
+			return 0, err
+		}
+		return 0, fn(adapter)
+	})
+}
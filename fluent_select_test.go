@@ -0,0 +1,66 @@
+package goquery
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFluentSelectBuild(t *testing.T) {
+	store := &DataStore{Dialect: postgresDialect}
+	main := &TableDataSet{Name: "users"}
+
+	stmt, args := store.Select(main).
+		Columns("id", "name").
+		Where(C("active").Eq(true)).
+		OrderBy("id").
+		Limit(10).
+		Offset(5).
+		build()
+
+	if want := `SELECT id, name FROM users WHERE "active" = $1 ORDER BY id LIMIT 10 OFFSET 5`; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+	if want := []interface{}{true}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestFluentSelectBuildJoinGroupHavingForUpdate(t *testing.T) {
+	store := &DataStore{Dialect: postgresDialect}
+	main := &TableDataSet{Name: "orders"}
+	other := &TableDataSet{Name: "customers"}
+
+	stmt, args := store.Select(main).
+		Join(other, C("orders.customer_id").Eq(C("customers.id"))).
+		GroupBy("customers.id").
+		Having(C("count").Gt(1)).
+		ForUpdate().
+		build()
+
+	if want := `SELECT * FROM orders JOIN customers ON "orders"."customer_id" = "customers"."id" GROUP BY customers.id HAVING "count" > $1 FOR UPDATE`; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+	if want := []interface{}{1}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestExpressionInEmptyValues(t *testing.T) {
+	sql, args := C("id").In().render(postgresDialect, 0)
+	if want := "1 = 0"; sql != want {
+		t.Errorf("empty In() rendered %q, want %q", sql, want)
+	}
+	if len(args) != 0 {
+		t.Errorf("empty In() args = %v, want none", args)
+	}
+}
+
+func TestExpressionInNonEmptyValues(t *testing.T) {
+	sql, args := C("id").In(1, 2, 3).render(postgresDialect, 0)
+	if want := `"id" IN ($1, $2, $3)`; sql != want {
+		t.Errorf("In() rendered %q, want %q", sql, want)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(args, want) {
+		t.Errorf("In() args = %v, want %v", args, want)
+	}
+}
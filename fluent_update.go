@@ -0,0 +1,145 @@
+package goquery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// FluentUpdate builds and runs an UPDATE against a DataSet. A single Join
+// makes it a multi-table update, rendered per
+// DbDialect.UseFromClauseForMultipleUpdateTables: "UPDATE a SET ... FROM
+// b WHERE ..." on Postgres-style dialects, "UPDATE a JOIN b ON ... SET
+// ..." on MySQL-style ones. Obtain one from DataStore.Update.
+type FluentUpdate struct {
+	store *DataStore
+	ds    DataSet
+	ctx   context.Context
+	tx    *Tx
+
+	set      map[string]interface{}
+	setOrder []string
+	where    Expression
+	joins    []joinClause
+}
+
+// Update begins building a FluentUpdate against ds.
+func (d *DataStore) Update(ds DataSet) *FluentUpdate {
+	return &FluentUpdate{store: d, ds: ds, set: map[string]interface{}{}}
+}
+
+// Context sets the context propagated to the underlying *sql.DB call and
+// to every registered QueryHook. Defaults to context.Background().
+func (u *FluentUpdate) Context(ctx context.Context) *FluentUpdate { u.ctx = ctx; return u }
+
+func (u *FluentUpdate) Tx(tx *Tx) *FluentUpdate { u.tx = tx; return u }
+
+// Set assigns col the given value. Calling Set again for the same column
+// overwrites the value but keeps its original position in the SET list.
+func (u *FluentUpdate) Set(col string, value interface{}) *FluentUpdate {
+	if _, exists := u.set[col]; !exists {
+		u.setOrder = append(u.setOrder, col)
+	}
+	u.set[col] = value
+	return u
+}
+
+func (u *FluentUpdate) Where(expr Expression) *FluentUpdate { u.where = expr; return u }
+
+// Join makes this a multi-table update against ds, with on as the join
+// condition.
+func (u *FluentUpdate) Join(ds DataSet, on Expression) *FluentUpdate {
+	u.joins = append(u.joins, joinClause{ds: ds, on: on, kind: "JOIN"})
+	return u
+}
+
+func (u *FluentUpdate) ctxOrBackground() context.Context {
+	if u.ctx != nil {
+		return u.ctx
+	}
+	return context.Background()
+}
+
+// renderSet renders the SET assignments starting at the given arg base,
+// so callers can place it correctly relative to whatever other clauses
+// share the statement's args slice.
+func (u *FluentUpdate) renderSet(dialect DbDialect, argBase int) ([]string, []interface{}) {
+	setSQL := make([]string, len(u.setOrder))
+	var args []interface{}
+	for i, col := range u.setOrder {
+		args = append(args, u.set[col])
+		setSQL[i] = fmt.Sprintf("%s = %s", col, dialect.Bind(col, argBase+len(args)-1))
+	}
+	return setSQL, args
+}
+
+func (u *FluentUpdate) build() (string, []interface{}) {
+	dialect := u.store.Dialect
+
+	if len(u.joins) == 0 {
+		setSQL, args := u.renderSet(dialect, 0)
+		stmt := fmt.Sprintf("UPDATE %s SET %s", u.ds.Entity(), strings.Join(setSQL, ", "))
+		if u.where != nil {
+			whereSQL, whereArgs := u.where.render(dialect, len(args))
+			args = append(args, whereArgs...)
+			stmt += " WHERE " + whereSQL
+		}
+		return stmt, args
+	}
+
+	if dialect.UseFromClauseForMultipleUpdateTables {
+		setSQL, args := u.renderSet(dialect, 0)
+
+		fromTables := make([]string, len(u.joins))
+		conds := make([]Expression, len(u.joins))
+		for i, j := range u.joins {
+			fromTables[i] = j.ds.Entity()
+			conds[i] = j.on
+		}
+		if u.where != nil {
+			conds = append(conds, u.where)
+		}
+
+		stmt := fmt.Sprintf("UPDATE %s SET %s FROM %s", u.ds.Entity(), strings.Join(setSQL, ", "), strings.Join(fromTables, ", "))
+		whereSQL, whereArgs := And(conds...).render(dialect, len(args))
+		args = append(args, whereArgs...)
+		stmt += " WHERE " + whereSQL
+		return stmt, args
+	}
+
+	// Args must be appended in the same order their placeholders appear in
+	// stmt (JOIN...ON before SET), since unnumbered "?" dialects bind
+	// strictly positionally.
+	var args []interface{}
+	stmt := fmt.Sprintf("UPDATE %s", u.ds.Entity())
+	for _, j := range u.joins {
+		onSQL, onArgs := j.on.render(dialect, len(args))
+		args = append(args, onArgs...)
+		stmt += fmt.Sprintf(" %s %s ON %s", j.kind, j.ds.Entity(), onSQL)
+	}
+
+	setSQL, setArgs := u.renderSet(dialect, len(args))
+	args = append(args, setArgs...)
+	stmt += " SET " + strings.Join(setSQL, ", ")
+
+	if u.where != nil {
+		whereSQL, whereArgs := u.where.render(dialect, len(args))
+		args = append(args, whereArgs...)
+		stmt += " WHERE " + whereSQL
+	}
+	return stmt, args
+}
+
+// Execute runs the built UPDATE and returns any error from the
+// underlying exec.
+func (u *FluentUpdate) Execute() error {
+	stmt, args := u.build()
+	return u.store.runHooks(u.ctxOrBackground(), OpUpdate, u.ds.Entity(), stmt, args, func(ctx context.Context) (int64, error) {
+		res, err := u.store.exec(ctx, u.tx, stmt, args)
+		if err != nil {
+			return 0, err
+		}
+		n, _ := res.RowsAffected()
+		return n, nil
+	})
+}
@@ -0,0 +1,65 @@
+package goquery
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFluentUpdateBuildSingleTable(t *testing.T) {
+	store := &DataStore{Dialect: postgresDialect}
+	main := &TableDataSet{Name: "users"}
+
+	stmt, args := store.Update(main).
+		Set("status", "active").
+		Where(C("id").Eq(5)).
+		build()
+
+	if want := `UPDATE users SET status = $1 WHERE "id" = $2`; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+	if want := []interface{}{"active", 5}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+// TestFluentUpdateBuildJoinArgOrder is a regression test: for
+// UseFromClauseForMultipleUpdateTables=false dialects (e.g. MySQL), the
+// JOIN...ON clause is rendered before SET in stmt, so its args must be
+// appended before SET's for "?" placeholders to bind to the right values.
+func TestFluentUpdateBuildJoinArgOrder(t *testing.T) {
+	store := &DataStore{Dialect: mysqlDialect}
+	main := &TableDataSet{Name: "main"}
+	other := &TableDataSet{Name: "other"}
+
+	stmt, args := store.Update(main).
+		Set("status", "active").
+		Join(other, C("other.active").Eq(true)).
+		Where(C("id").Eq(5)).
+		build()
+
+	if want := "UPDATE main JOIN other ON `other`.`active` = ? SET status = ? WHERE `id` = ?"; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+	if want := []interface{}{true, "active", 5}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestFluentUpdateBuildFromClauseStyle(t *testing.T) {
+	store := &DataStore{Dialect: postgresDialect}
+	main := &TableDataSet{Name: "main"}
+	other := &TableDataSet{Name: "other"}
+
+	stmt, args := store.Update(main).
+		Set("status", "active").
+		Join(other, C("other.id").Eq(C("main.other_id"))).
+		Where(C("main.id").Eq(5)).
+		build()
+
+	if want := `UPDATE main SET status = $1 FROM other WHERE ("other"."id" = "main"."other_id" AND "main"."id" = $2)`; stmt != want {
+		t.Errorf("stmt = %q, want %q", stmt, want)
+	}
+	if want := []interface{}{"active", 5}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
@@ -0,0 +1,179 @@
+package goquery
+
+import (
+	"context"
+	"time"
+)
+
+// QueryOp identifies what kind of statement a QueryEvent describes.
+type QueryOp string
+
+const (
+	OpSelect QueryOp = "select"
+	OpInsert QueryOp = "insert"
+	OpUpdate QueryOp = "update"
+	OpDelete QueryOp = "delete"
+	OpExec   QueryOp = "exec"
+)
+
+// QueryEvent describes a single statement sent to the database, for
+// QueryHook implementations to log, trace, or otherwise observe.
+type QueryEvent struct {
+	SQL       string
+	Args      []interface{}
+	Op        QueryOp
+	Entity    string
+	StartedAt time.Time
+	Rows      int64
+	Err       error
+}
+
+// QueryHook lets callers observe every statement a DataStore runs, e.g. to
+// log it, start/end a trace span, or emit slow-query warnings. Modeled on
+// Bun's hook interface.
+type QueryHook interface {
+	// BeforeQuery is called right before a statement runs. The returned
+	// context is threaded through the call and into AfterQuery, so a hook
+	// can stash request-scoped state (e.g. a started trace span) on it.
+	BeforeQuery(ctx context.Context, evt QueryEvent) context.Context
+	// AfterQuery is called once the statement finishes, with evt.Rows and
+	// evt.Err filled in.
+	AfterQuery(ctx context.Context, evt QueryEvent)
+}
+
+// defaultLatencyBucketsMs are the cumulative (Prometheus-style) histogram
+// bucket upper bounds, in milliseconds, used for every LatencyHistogram.
+var defaultLatencyBucketsMs = []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+
+// LatencyHistogram is a cumulative histogram of query latencies: Counts[i]
+// is the number of queries that took at most Buckets[i] milliseconds.
+// Overflow counts queries slower than the largest bucket.
+type LatencyHistogram struct {
+	Buckets  []float64
+	Counts   []int64
+	Overflow int64
+}
+
+func newLatencyHistogram() LatencyHistogram {
+	return LatencyHistogram{Buckets: defaultLatencyBucketsMs, Counts: make([]int64, len(defaultLatencyBucketsMs))}
+}
+
+func (h *LatencyHistogram) observe(d time.Duration) {
+	ms := float64(d) / float64(time.Millisecond)
+	observed := false
+	for i, b := range h.Buckets {
+		if ms <= b {
+			h.Counts[i]++
+			observed = true
+		}
+	}
+	if !observed {
+		h.Overflow++
+	}
+}
+
+func (h LatencyHistogram) clone() LatencyHistogram {
+	counts := make([]int64, len(h.Counts))
+	copy(counts, h.Counts)
+	return LatencyHistogram{Buckets: h.Buckets, Counts: counts, Overflow: h.Overflow}
+}
+
+// OpStats accumulates counters and a latency histogram for one QueryOp.
+type OpStats struct {
+	Queries int64
+	Errors  int64
+	Rows    int64
+	Latency LatencyHistogram
+}
+
+// DBStats accumulates counters and a latency histogram across every query
+// a DataStore has run.
+type DBStats struct {
+	Queries int64
+	Errors  int64
+	Rows    int64
+	Latency LatencyHistogram
+	ByOp    map[QueryOp]OpStats
+}
+
+// AddQueryHook registers h to observe every query this DataStore runs
+// from now on.
+func (d *DataStore) AddQueryHook(h QueryHook) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.hooks = append(d.hooks, h)
+}
+
+// Stats returns a snapshot of the counters accumulated so far.
+func (d *DataStore) Stats() DBStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	snapshot := DBStats{
+		Queries: d.stats.Queries,
+		Errors:  d.stats.Errors,
+		Rows:    d.stats.Rows,
+		Latency: d.stats.Latency.clone(),
+		ByOp:    make(map[QueryOp]OpStats, len(d.stats.ByOp)),
+	}
+	for op, s := range d.stats.ByOp {
+		s.Latency = s.Latency.clone()
+		snapshot.ByOp[op] = s
+	}
+	return snapshot
+}
+
+func (d *DataStore) runHooks(ctx context.Context, op QueryOp, entity, sqlText string, args []interface{}, fn func(ctx context.Context) (rowCount int64, err error)) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	d.mu.Lock()
+	hooks := d.hooks
+	d.mu.Unlock()
+
+	evt := QueryEvent{SQL: sqlText, Args: args, Op: op, Entity: entity, StartedAt: time.Now()}
+	for _, h := range hooks {
+		ctx = h.BeforeQuery(ctx, evt)
+	}
+
+	rows, err := fn(ctx)
+	elapsed := time.Since(evt.StartedAt)
+
+	evt.Rows = rows
+	evt.Err = err
+	for _, h := range hooks {
+		h.AfterQuery(ctx, evt)
+	}
+
+	d.recordStats(op, rows, elapsed, err)
+	return err
+}
+
+func (d *DataStore) recordStats(op QueryOp, rows int64, elapsed time.Duration, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.stats.ByOp == nil {
+		d.stats.ByOp = make(map[QueryOp]OpStats)
+	}
+	opStats := d.stats.ByOp[op]
+	if opStats.Latency.Counts == nil {
+		opStats.Latency = newLatencyHistogram()
+	}
+	if d.stats.Latency.Counts == nil {
+		d.stats.Latency = newLatencyHistogram()
+	}
+
+	d.stats.Queries++
+	d.stats.Rows += rows
+	d.stats.Latency.observe(elapsed)
+	opStats.Queries++
+	opStats.Rows += rows
+	opStats.Latency.observe(elapsed)
+	if err != nil {
+		d.stats.Errors++
+		opStats.Errors++
+	}
+
+	d.stats.ByOp[op] = opStats
+}
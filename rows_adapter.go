@@ -0,0 +1,62 @@
+package goquery
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+)
+
+// sqlRowsAdapter adapts a *sql.Rows to the Rows interface expected by
+// RowFunction, so FluentSelect.Iterate can hand callers the same
+// abstraction used throughout the rest of the package.
+type sqlRowsAdapter struct {
+	rows *sql.Rows
+	cols []string
+}
+
+func newSQLRowsAdapter(rows *sql.Rows) (*sqlRowsAdapter, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	return &sqlRowsAdapter{rows: rows, cols: cols}, nil
+}
+
+func (a *sqlRowsAdapter) Columns() ([]string, error) { return a.cols, nil }
+
+func (a *sqlRowsAdapter) ColumnTypes() ([]reflect.Type, error) {
+	types, err := a.rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]reflect.Type, len(types))
+	for i, t := range types {
+		out[i] = t.ScanType()
+	}
+	return out, nil
+}
+
+func (a *sqlRowsAdapter) Next() bool { return a.rows.Next() }
+
+func (a *sqlRowsAdapter) Scan(dest ...interface{}) error { return a.rows.Scan(dest...) }
+
+// ScanStruct scans the current row into dest by matching a.cols against
+// dest's `db`-tagged fields, the same lookup InsertRecs/Scan use.
+func (a *sqlRowsAdapter) ScanStruct(dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return errors.New("goquery: ScanStruct requires a pointer to a struct")
+	}
+	elem := v.Elem()
+	ptrs := make([]interface{}, len(a.cols))
+	for i, c := range a.cols {
+		idx, err := fieldIndexForColumn(elem.Type(), c)
+		if err != nil {
+			return err
+		}
+		ptrs[i] = elem.Field(idx).Addr().Interface()
+	}
+	return a.rows.Scan(ptrs...)
+}
+
+func (a *sqlRowsAdapter) Close() error { return a.rows.Close() }